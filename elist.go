@@ -7,6 +7,9 @@ Functions in this package.
 
 Elist::
 Elist::Error
+Elist::Unwrap
+Elist::Is
+Elist::As
 - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
 New
 Newf
@@ -61,18 +64,33 @@ in the following example.
 Then the caller of function0() may either print the error message which is
 returned, or else push a new message onto the returned message and pass that as
 a return value.
+
+Since Go 1.13, the standard "errors" package offers Is(), As() and Unwrap() for
+walking a chain of wrapped errors. An Elist now cooperates with that chain: each
+*Elist implements Unwrap() error, so errors.Unwrap() descends into the next
+node, preferring a wrapped non-Elist error over the next Elist node when one is
+present at the payload. This means an Elist may be pushed onto a third-party
+wrapped error (or vice versa) and errors.Is()/errors.As() still work across the
+whole chain, e.g.
+    return elist.Push(fmt.Errorf("open config: %w", io.EOF), "loadConfig:")
+    ...
+    if errors.Is(err, io.EOF) { ... }
+
+Call-stack capture at each New()/Push() call-site is available, but off by
+default; see SetStackCaptureEnabled() and Elist::Format() in elist_stack.go.
 */
 package elist
 
 // External libraries.
+import "errors"
 import "fmt"
+import "reflect"
 
 // import "strings"
 // import "net/http"
 // import "log"
 // import "io"
 // import "time"
-// import "errors"
 
 //=============================================================================
 //=============================================================================
@@ -92,8 +110,12 @@ type Elist struct {
     //------------------//
     //      Elist::     //
     //------------------//
-    next  *Elist      // Next node in a singly linked stack.
-    value interface{} // The payload of the error node.
+    next   *Elist      // Next node in a singly linked stack.
+    value  interface{} // The payload of the error node.
+    pc     []uintptr    // Captured call-stack of this node, if enabled. See elist_stack.go.
+    op     string       // Optional operation name attached via Ef()/Pushf2(). See elist_fields.go.
+    fields []kv         // Optional structured key/value pairs. See elist_fields.go.
+    kind   Kind         // Optional error category. See elist_kind.go.
 }
 
 /*
@@ -116,33 +138,149 @@ func (p *Elist) Error() string {
     var str string = ""
     var n int = 1
     for q := p; q != nil; q = q.next {
-        var msg string = ""
-
-        switch x := q.value.(type) {
-        case string:
-            // An Elist object.
-            msg = fmt.Sprintf(": \"%s\"", x)
-        case error:
-            // An old-style error object.
-            //            msg = fmt.Sprintf(": \"%v\"", x.Error());
-            msg = fmt.Sprintf(": \"%v\"", x)
-        case nil:
-            // Unrecognised object.
-            msg = fmt.Sprint(": [error == nil]")
-        default:
-            // Unrecognised object.
-            msg = fmt.Sprintf(": [Unrecognized error] \"%v\"", x)
-        }   // End of switch.
-
-        str += fmt.Sprintf("Error %d%s.\n", n, msg)
+        str += elistErrorLine(n, q)
         n += 1
     }
     return str
 }   // End of function Elist::Error.
 
+// Render the single "Error N: ...." line for node q. Factored out of
+// Elist::Error() so that Elist::Format() (see elist_stack.go) can print the
+// same line before appending q's captured stack, if it has one.
+func elistErrorLine(n int, q *Elist) string {
+    var msg string = ""
+
+    switch x := q.value.(type) {
+    case string:
+        // An Elist object.
+        msg = fmt.Sprintf(": \"%s\"", x)
+    case error:
+        // An old-style error object.
+        //            msg = fmt.Sprintf(": \"%v\"", x.Error());
+        msg = fmt.Sprintf(": \"%v\"", x)
+    case nil:
+        // Unrecognised object.
+        msg = fmt.Sprint(": [error == nil]")
+    default:
+        // Unrecognised object.
+        msg = fmt.Sprintf(": [Unrecognized error] \"%v\"", x)
+    }   // End of switch.
+
+    return fmt.Sprintf("Error %d%s.\n", n, msg)
+}   // End of function elistErrorLine.
+
+/*
+Return the error which is one level closer to the root cause than p.
+If the payload of p is itself a non-Elist "error", that payload is returned,
+since it is the underlying cause which was wrapped at this node. Otherwise, if
+p has a next node in the error-message-stack, that next node is returned.
+Otherwise, Unwrap() returns nil, indicating that p is the innermost node.
+
+This is the method which the standard "errors" package looks for, so that
+errors.Unwrap(), errors.Is() and errors.As() can walk all the way down an
+Elist's error-message-stack and beyond, into any non-Elist error which was
+passed to New()/Push() as the wrapped payload. In particular, repeatedly
+calling errors.Unwrap() on the outermost node of a chain which was built purely
+out of elist.Push() calls must eventually reach the innermost leaf error, the
+same invariant observed in "pkg/errors" and "go-errors/errors".
+*/
+func (p *Elist) Unwrap() error {
+    //------------------//
+    //   Elist::Unwrap  //
+    //------------------//
+    if p == nil {
+        return nil
+    }
+    // Prefer the wrapped non-Elist error in the payload, if there is one.
+    if e, ok := p.value.(error); ok {
+        return e
+    }
+    // Otherwise fall through to the next node in the stack.
+    if p.next == nil {
+        return nil
+    }
+    return p.next
+}   // End of function Elist::Unwrap.
+
+/*
+Report whether p matches the given target error, for use by errors.Is().
+A match occurs when the payload of p is a wrapped "error" which is itself
+equal (==) to target, when target is also an *Elist whose payload is the
+identical string message as the payload of p, or when target is a bare Kind
+value (see elist_kind.go) equal to the Kind attached to p. errors.Is() already
+takes care of walking the rest of the chain via Unwrap(), so Is() here need
+only judge whether p itself, not its descendants, is a match. This is what
+lets callers write "errors.Is(err, elist.K.NotExist)" to test by category
+without needing a specific sentinel error value for every node.
+
+Like the standard library's own errors.Is(), the payload/target "==" below is
+only attempted when target's concrete type is comparable; a non-comparable
+concrete type (one holding a slice, map or func) can never equal anything via
+"==", and attempting the comparison anyway panics at runtime instead of
+simply returning false.
+*/
+func (p *Elist) Is(target error) bool {
+    //------------------//
+    //    Elist::Is     //
+    //------------------//
+    if p == nil || target == nil {
+        return false
+    }
+    if e, ok := p.value.(error); ok && reflect.TypeOf(target).Comparable() && e == target {
+        return true
+    }
+    if s, ok := p.value.(string); ok {
+        if t, ok := target.(*Elist); ok {
+            if ts, ok := t.value.(string); ok && ts == s {
+                return true
+            }
+        }
+    }
+    if k, ok := target.(Kind); ok && p.kind != "" && p.kind == k {
+        return true
+    }
+    return false
+}   // End of function Elist::Is.
+
+/*
+Attempt to assign the wrapped payload of p into target, for use by errors.As().
+If the payload of p is a non-Elist "error", As() defers to errors.As() on that
+payload, so that target may be set either from the payload itself or from
+anything further down inside it. As with Is(), errors.As() already walks the
+rest of the chain via Unwrap(), so only the payload of p need be considered
+here.
+*/
+func (p *Elist) As(target interface{}) bool {
+    //------------------//
+    //    Elist::As     //
+    //------------------//
+    if p == nil || target == nil {
+        return false
+    }
+    if e, ok := p.value.(error); ok {
+        return errors.As(e, target)
+    }
+    return false
+}   // End of function Elist::As.
+
 //=============================================================================
 //=============================================================================
 
+// Build a new Elist node from the string s. Both New() and Newf() call this
+// directly (rather than Newf() calling New()) so that the captured call-stack,
+// when stack capture is enabled, always starts at the caller of New()/Newf(),
+// not at one of these two functions themselves. See elist_stack.go.
+func newElist(s string) *Elist {
+    p := new(Elist)
+    // This will never happen.
+    if p == nil {
+        return nil
+    }
+    p.value = s
+    p.pc = captureStack(stackSkipFrames)
+    return p
+}   // End of function newElist.
+
 /*
 Create a new Elist error-message-stack from a given string.
 Usage example:
@@ -154,12 +292,10 @@ func New(s string) error {
     //------------------//
     //        New       //
     //------------------//
-    p := new(Elist)
-    // This will never happen.
+    p := newElist(s)
     if p == nil {
         return nil
     }
-    p.value = s
     return p
 }   // End of function New.
 
@@ -184,9 +320,41 @@ func Newf(format string, args ...interface{}) error {
         return New(fmt.Sprintf(format, argsCopy...));
     ------------------------------------------------------------------------------*/
     // The quick way to do it!!
-    return New(fmt.Sprintf(format, args...))
+    p := newElist(fmt.Sprintf(format, args...))
+    if p == nil {
+        return nil
+    }
+    return p
 }   // End of function Newf.
 
+// Build a new Elist node which pushes the message s onto the error e. Both
+// Push() and Pushf() call this directly (rather than Pushf() calling Push())
+// for the same call-stack-depth reason as newElist() above.
+func pushElist(e error, s string) *Elist {
+    p := new(Elist)
+    // This will never happen.
+    if p == nil {
+        return nil
+    }
+    p.value = s
+    p.pc = captureStack(stackSkipFrames)
+    // A nil input-error is not an error. It is a feature!
+    if e == nil {
+        return p
+    }
+    q, ok := e.(*Elist)
+    if !ok {
+        q = new(Elist)
+        // Extract the string from the old error and use that.
+        //        q.value = e.Error();
+        // Make a copy of the entire error.
+        // It might contain more than the string.
+        q.value = e
+    }
+    p.next = q
+    return p
+}   // End of function pushElist.
+
 /*
 Return a newly created Elist error-message-stack with the new message s at the
 head of the stack.
@@ -213,26 +381,10 @@ func Push(e error, s string) error {
     //------------------//
     //       Push       //
     //------------------//
-    p := new(Elist)
-    // This will never happen.
+    p := pushElist(e, s)
     if p == nil {
         return nil
     }
-    p.value = s
-    // A nil input-error is not an error. It is a feature!
-    if e == nil {
-        return p
-    }
-    q, ok := e.(*Elist)
-    if !ok {
-        q = new(Elist)
-        // Extract the string from the old error and use that.
-        //        q.value = e.Error();
-        // Make a copy of the entire error.
-        // It might contain more than the string.
-        q.value = e
-    }
-    p.next = q
     return p
 }   // End of function Push.
 
@@ -249,5 +401,9 @@ func Pushf(e error, format string, args ...interface{}) error {
     //------------------//
     //       Pushf      //
     //------------------//
-    return Push(e, fmt.Sprintf(format, args...))
+    p := pushElist(e, fmt.Sprintf(format, args...))
+    if p == nil {
+        return nil
+    }
+    return p
 }   // End of function Pushf.
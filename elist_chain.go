@@ -0,0 +1,169 @@
+// src/go/elist_chain.go   2026-7-29.
+// Chain-walking and introspection helpers for Elist, in the style of
+// "pkg/errors".Cause() and the eluv-io "errorglue" chain printers.
+/*-------------------------------------------------------------------------
+Functions in this file.
+
+Cause
+Root
+List
+Walk
+-------------------------------------------------------------------------*/
+
+/*
+Elist::Error() and Elist::FormatAs() render an entire error-message-stack as
+text, but callers sometimes want to inspect the chain programmatically instead
+of just printing it. This file adds a small introspection subsystem on top of
+the Unwrap()/Is()/As() support in elist.go:
+
+  - Cause() returns the oldest entry in the chain, descending through Elist
+    nodes and through any wrapped non-Elist error which implements Unwrap()
+    (Go 1.13 and "fmt.Errorf(%w)") or the older "interface{ Cause() error }"
+    (pre-1.13 "pkg/errors"), so mixed chains unwind uniformly.
+  - List() returns the whole chain as a slice, outermost first.
+  - Walk() visits the chain one frame at a time, stopping early if the
+    callback asks it to.
+*/
+package elist
+
+// External libraries.
+import "errors"
+
+/*
+Return the oldest (innermost) entry in the chain of err. Cause() descends
+through *Elist nodes via their next pointer, and through any wrapped
+non-Elist error which implements "interface{ Cause() error }" (the pre-1.13
+"pkg/errors" convention) or the standard Unwrap() error, continuing until
+nothing further unwraps. If the last entry reached is an *Elist carrying a
+plain string message (i.e. one created by New()/Push() with no wrapped
+error), that string is returned as a new error via errors.New(), since a
+*Elist is not meant to be handed back to callers as a leaf value. Returns nil
+if err is nil.
+*/
+func Cause(err error) error {
+    //------------------//
+    //       Cause      //
+    //------------------//
+    if err == nil {
+        return nil
+    }
+    cur := err
+    for {
+        if c, ok := cur.(interface{ Cause() error }); ok {
+            if next := c.Cause(); next != nil {
+                cur = next
+                continue
+            }
+        }
+        if next := errors.Unwrap(cur); next != nil {
+            cur = next
+            continue
+        }
+        break
+    }
+    if p, ok := cur.(*Elist); ok {
+        if s, ok := p.value.(string); ok {
+            return errors.New(s)
+        }
+    }
+    return cur
+}   // End of function Cause.
+
+/*
+Root is an alias for Cause(). The two names exist because different
+libraries in this lineage use different words for "the oldest cause in the
+chain": "pkg/errors" calls it Cause(), but unlike "pkg/errors".Cause(), which
+stops at the first non-wrapped error it finds, this package's Cause() keeps
+descending (via Unwrap()/Cause()) until nothing further unwraps, so Cause()
+and Root() here always agree.
+*/
+func Root(err error) error {
+    //------------------//
+    //       Root       //
+    //------------------//
+    return Cause(err)
+}   // End of function Root.
+
+/*
+Return the chain of err as a slice, in the same LIFO order as Elist::Error()
+prints it: the outermost (most recent) entry first, the innermost (oldest)
+entry last. Each element is either the original wrapped "error" value found
+at that node's payload, or, for a node created from a plain string (via
+New()/Push()/Ef()/Pushf2() with no wrapped error), a new error created with
+errors.New() from that string. If err is not an *Elist at all, List() returns
+a single-element slice containing err itself.
+*/
+func List(err error) []error {
+    //------------------//
+    //        List      //
+    //------------------//
+    var result []error
+    cur := err
+    for cur != nil {
+        p, ok := cur.(*Elist)
+        if !ok {
+            result = append(result, cur)
+            break
+        }
+        switch x := p.value.(type) {
+        case string:
+            result = append(result, errors.New(x))
+        case error:
+            result = append(result, x)
+        default:
+            result = append(result, errors.New(elistNodeText(p)))
+        }
+        // p.next may be a nil *Elist; assigning it straight to the "error"
+        // interface cur would leave cur holding a non-nil interface wrapping
+        // a nil pointer, so the nil check above would never trigger.
+        if p.next == nil {
+            break
+        }
+        cur = p.next
+    }
+    return result
+}   // End of function List.
+
+/*
+Invoke fn once per frame of the chain of err, outermost first, stopping as
+soon as fn returns false. For each *Elist frame, fn receives the zero-based
+frame index i, the frame's message text msg, and, if the frame wraps a
+non-Elist error, that error as wrapped (otherwise wrapped is nil). If err is
+not an *Elist, fn is invoked exactly once, with i == 0, msg == err.Error()
+and wrapped == err.
+*/
+func Walk(err error, fn func(i int, msg string, wrapped error) bool) {
+    //------------------//
+    //       Walk       //
+    //------------------//
+    i := 0
+    cur := err
+    for cur != nil {
+        p, ok := cur.(*Elist)
+        if !ok {
+            fn(i, cur.Error(), cur)
+            return
+        }
+        var msg string
+        var wrapped error
+        switch x := p.value.(type) {
+        case string:
+            msg = x
+        case error:
+            msg = elistNodeText(p)
+            wrapped = x
+        default:
+            msg = elistNodeText(p)
+        }
+        if !fn(i, msg, wrapped) {
+            return
+        }
+        i += 1
+        // Same nil-interface pitfall as in List(): don't let a nil *Elist
+        // masquerade as a non-nil "error".
+        if p.next == nil {
+            return
+        }
+        cur = p.next
+    }
+}   // End of function Walk.
@@ -0,0 +1,324 @@
+// src/go/elist_fields.go   2026-7-29.
+// Structured key/value context fields on Elist nodes, and pluggable renderers.
+/*-------------------------------------------------------------------------
+Functions in this file.
+
+Elist::FormatAs
+Elist::Fields
+- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+Ef
+Pushf2
+Render
+-------------------------------------------------------------------------*/
+
+/*
+Following the "eluv-io/errors-go" design, an Elist node may carry structured
+context as key/value pairs attached at the point where the error was wrapped,
+instead of everything being baked into a single sprintf'd message string.
+
+    return elist.Ef(elist.Op("config.Load"), "could not open file",
+        "path", path, "attempt", n)
+
+Ef() and Pushf2() both take the same kind of argument list: an optional
+leading Op and/or Kind (see elist_kind.go) in either order, then an optional
+message string, then any number of alternating "key", value pairs. The
+original New()/Push() are unaffected by any of this; their single string
+argument becomes the msg of the new node exactly as before, with no op, kind
+or fields, so every existing call site keeps working unchanged.
+
+Elist::Error() keeps rendering the traceback the way it always has. For
+structured consumers, FormatAs() (and the package-level Render()) offer two
+further renderings selected via the Formatter type: CompactFormatter, a
+single "op [kind=...] [fields] msg : op [fields] msg : leaf" line, and
+JSONFormatter, a JSON array with one object per frame. (This is named
+FormatAs rather than Format to avoid colliding with the fmt.Formatter method
+of the same name added in elist_stack.go.)
+*/
+package elist
+
+// External libraries.
+import "encoding/json"
+import "fmt"
+import "strings"
+
+// One structured key/value pair attached to a node via Ef()/Pushf2(). Stored
+// in a slice, not a map, so that ordering is preserved and duplicate keys are
+// allowed, as called for by the structured-fields design.
+type kv struct {
+    key   string
+    value interface{}
+}
+
+// Field is the exported, read-only view of one kv pair, returned by
+// Elist::Fields().
+type Field struct {
+    Key   string
+    Value interface{}
+}
+
+// Op identifies the operation in progress at a frame, e.g. "config.Load", for
+// use as the (optional) leading argument to Ef()/Pushf2().
+type Op string
+
+// Split the variadic arguments of Ef()/Pushf2() into an optional leading Op
+// and/or Kind (in either order), an optional message string, and the
+// remaining alternating key/value pairs.
+func splitFieldArgs(args []interface{}) (op string, kind Kind, msg string, fields []kv) {
+    //---------------------//
+    //   splitFieldArgs    //
+    //---------------------//
+    i := 0
+leading:
+    for i < len(args) {
+        switch v := args[i].(type) {
+        case Op:
+            op = string(v)
+            i += 1
+        case Kind:
+            kind = v
+            i += 1
+        default:
+            break leading
+        }
+    }
+    rest := args[i:]
+    // If an odd number of arguments remain, the first of them, if it is a
+    // string, is the message.
+    if len(rest)%2 == 1 {
+        if s, ok := rest[0].(string); ok {
+            msg = s
+            rest = rest[1:]
+        }
+    }
+    for j := 0; j+1 < len(rest); j += 2 {
+        key, _ := rest[j].(string)
+        fields = append(fields, kv{key: key, value: rest[j+1]})
+    }
+    return op, kind, msg, fields
+}   // End of function splitFieldArgs.
+
+/*
+Create a new Elist error-message-stack node carrying structured context.
+Usage example:
+    return elist.Ef(elist.Op("config.Load"), "could not open file",
+        "path", path, "attempt", n);
+The return value from elist.Ef() is of type *Elist, which is assigned to an
+"error" interface, exactly like New().
+*/
+func Ef(args ...interface{}) error {
+    //------------------//
+    //        Ef        //
+    //------------------//
+    op, kind, msg, fields := splitFieldArgs(args)
+    p := newElist(msg)
+    if p == nil {
+        return nil
+    }
+    p.op = op
+    p.kind = kind
+    p.fields = fields
+    return p
+}   // End of function Ef.
+
+/*
+Structured-context version of Push(). Pushes a new node carrying op, msg and
+fields (parsed from args exactly as in Ef()) onto the error e, exactly as
+Push() pushes a plain string.
+Usage example:
+    return elist.Pushf2(E, elist.Op("config.Load"), "could not open file",
+        "path", path);
+*/
+func Pushf2(e error, args ...interface{}) error {
+    //------------------//
+    //      Pushf2      //
+    //------------------//
+    op, kind, msg, fields := splitFieldArgs(args)
+    p := pushElist(e, msg)
+    if p == nil {
+        return nil
+    }
+    p.op = op
+    p.kind = kind
+    p.fields = fields
+    return p
+}   // End of function Pushf2.
+
+/*
+Return the structured key/value fields attached to this node via Ef()/Pushf2().
+Returns nil for a node created by plain New()/Push().
+*/
+func (p *Elist) Fields() []Field {
+    //------------------//
+    //   Elist::Fields  //
+    //------------------//
+    if p == nil || len(p.fields) == 0 {
+        return nil
+    }
+    result := make([]Field, len(p.fields))
+    for i, f := range p.fields {
+        result[i] = Field{Key: f.key, Value: f.value}
+    }
+    return result
+}   // End of function Elist::Fields.
+
+// Return the plain message text of node q: the string payload if q.value is a
+// string, the wrapped error's message if q.value is an error, or a fallback
+// description otherwise. Used by the compact and JSON renderers below; the
+// plain-text renderer, Elist::Error(), has its own long-standing version of
+// this same logic in elistErrorLine().
+func elistNodeText(q *Elist) string {
+    //------------------//
+    //   elistNodeText  //
+    //------------------//
+    switch x := q.value.(type) {
+    case string:
+        return x
+    case error:
+        return x.Error()
+    case nil:
+        return "[error == nil]"
+    default:
+        return fmt.Sprintf("%v", x)
+    }
+}   // End of function elistNodeText.
+
+// Formatter selects one of the renderings offered by Elist::FormatAs() and
+// Render().
+type Formatter int
+
+const (
+    // PlainFormatter reproduces Elist::Error(): one "Error N: ...." line per
+    // node, outermost first.
+    PlainFormatter Formatter = iota
+    // CompactFormatter renders the chain on a single line:
+    //     op1 [kind=k1] key=val msg1 : op2 msg2 : leaf
+    CompactFormatter
+    // JSONFormatter renders the chain as a JSON array, one object per node,
+    // with "op", "msg", "kind", "fields" and "stack" members; empty members
+    // are omitted.
+    JSONFormatter
+)
+
+/*
+Render the error-message-stack rooted at p using the given Formatter.
+*/
+func (p *Elist) FormatAs(f Formatter) string {
+    //------------------------//
+    //    Elist::FormatAs     //
+    //------------------------//
+    if p == nil {
+        return ""
+    }
+    switch f {
+    case CompactFormatter:
+        return elistRenderCompact(p)
+    case JSONFormatter:
+        return elistRenderJSON(p)
+    default:
+        return p.Error()
+    }
+}   // End of function Elist::FormatAs.
+
+/*
+Render err using the given Formatter. If err is not an *Elist, it is treated
+as a single leaf node: CompactFormatter and PlainFormatter return err.Error(),
+and JSONFormatter returns a single-element JSON array.
+*/
+func Render(err error, f Formatter) string {
+    //------------------//
+    //      Render      //
+    //------------------//
+    if err == nil {
+        return ""
+    }
+    if p, ok := err.(*Elist); ok {
+        return p.FormatAs(f)
+    }
+    if f == JSONFormatter {
+        data, jerr := json.Marshal([]elistJSONNode{{Msg: err.Error()}})
+        if jerr != nil {
+            return err.Error()
+        }
+        return string(data)
+    }
+    return err.Error()
+}   // End of function Render.
+
+// Render the chain rooted at p as a single compact line:
+//     op1 [kind=k1] key=val ... msg1 : op2 ... msg2 : leaf
+func elistRenderCompact(p *Elist) string {
+    //------------------------//
+    //   elistRenderCompact   //
+    //------------------------//
+    var parts []string
+    for q := p; q != nil; q = q.next {
+        parts = append(parts, elistCompactNode(q))
+    }
+    return strings.Join(parts, " : ")
+}   // End of function elistRenderCompact.
+
+func elistCompactNode(q *Elist) string {
+    //------------------------//
+    //    elistCompactNode    //
+    //------------------------//
+    var b strings.Builder
+    if q.op != "" {
+        b.WriteString(q.op)
+    }
+    if q.kind != "" {
+        if b.Len() > 0 {
+            b.WriteString(" ")
+        }
+        fmt.Fprintf(&b, "[kind=%s]", string(q.kind))
+    }
+    for _, f := range q.fields {
+        if b.Len() > 0 {
+            b.WriteString(" ")
+        }
+        fmt.Fprintf(&b, "%s=%v", f.key, f.value)
+    }
+    if msg := elistNodeText(q); msg != "" {
+        if b.Len() > 0 {
+            b.WriteString(" ")
+        }
+        b.WriteString(msg)
+    }
+    return b.String()
+}   // End of function elistCompactNode.
+
+// JSON shape of one node: {op, msg, kind, fields, stack}.
+type elistJSONNode struct {
+    Op     string           `json:"op,omitempty"`
+    Msg    string           `json:"msg,omitempty"`
+    Kind   string           `json:"kind,omitempty"`
+    Fields []elistJSONField `json:"fields,omitempty"`
+    Stack  []string         `json:"stack,omitempty"`
+}
+
+type elistJSONField struct {
+    Key   string      `json:"key"`
+    Value interface{} `json:"value"`
+}
+
+// Render the chain rooted at p as a JSON array, outermost node first.
+func elistRenderJSON(p *Elist) string {
+    //------------------------//
+    //     elistRenderJSON    //
+    //------------------------//
+    var nodes []elistJSONNode
+    for q := p; q != nil; q = q.next {
+        node := elistJSONNode{Op: q.op, Msg: elistNodeText(q), Kind: string(q.kind)}
+        for _, f := range q.fields {
+            node.Fields = append(node.Fields, elistJSONField{Key: f.key, Value: f.value})
+        }
+        for _, frame := range q.StackTrace() {
+            node.Stack = append(node.Stack, fmt.Sprintf("%s:%d %s", frame.File, frame.Line, frame.Function))
+        }
+        nodes = append(nodes, node)
+    }
+    data, err := json.Marshal(nodes)
+    if err != nil {
+        return p.Error()
+    }
+    return string(data)
+}   // End of function elistRenderJSON.
@@ -0,0 +1,129 @@
+// src/go/elist_fields_test.go   2026-7-29.
+// Tests for structured key/value fields and the pluggable chain renderers
+// added on top of the original Elist package.
+package elist
+
+import (
+    "encoding/json"
+    "errors"
+    "strings"
+    "testing"
+)
+
+func TestSplitFieldArgsAmbiguousAllStringCase(t *testing.T) {
+    // Pinning down the documented, slightly surprising parse of a 3-element
+    // all-string arg list: the first string is always taken as msg, even
+    // though "a", "b", "c" could equally be read as three fields.
+    err := Ef("a", "b", "c")
+    p := err.(*Elist)
+    if p.value != "a" {
+        t.Fatalf("Ef(\"a\", \"b\", \"c\") msg = %v, want %q", p.value, "a")
+    }
+    fields := p.Fields()
+    if len(fields) != 1 || fields[0].Key != "b" || fields[0].Value != "c" {
+        t.Fatalf("Ef(\"a\", \"b\", \"c\") fields = %v, want [{b c}]", fields)
+    }
+}
+
+func TestEfPushf2RoundTripOpKindFields(t *testing.T) {
+    err := Ef(Op("config.Load"), K.NotExist, "could not open file",
+        "path", "/tmp/x", "attempt", 2)
+    p, ok := err.(*Elist)
+    if !ok {
+        t.Fatalf("Ef() did not return an *Elist")
+    }
+    if p.op != "config.Load" {
+        t.Fatalf("op = %q, want %q", p.op, "config.Load")
+    }
+    if p.kind != K.NotExist {
+        t.Fatalf("kind = %q, want %q", p.kind, K.NotExist)
+    }
+    if p.value != "could not open file" {
+        t.Fatalf("msg = %v, want %q", p.value, "could not open file")
+    }
+    fields := p.Fields()
+    if len(fields) != 2 || fields[0].Key != "path" || fields[0].Value != "/tmp/x" ||
+        fields[1].Key != "attempt" || fields[1].Value != 2 {
+        t.Fatalf("fields = %v, want [{path /tmp/x} {attempt 2}]", fields)
+    }
+
+    err2 := Pushf2(err, Op("handler"), "wrapping failed", "req", 7)
+    p2, ok := err2.(*Elist)
+    if !ok {
+        t.Fatalf("Pushf2() did not return an *Elist")
+    }
+    if p2.op != "handler" {
+        t.Fatalf("pushed op = %q, want %q", p2.op, "handler")
+    }
+    if p2.next != p {
+        t.Fatalf("Pushf2() did not chain onto the original node")
+    }
+}
+
+func TestFormatAsAllFormatters(t *testing.T) {
+    inner := Ef(Op("read"), "leaf failure", "file", "a.txt")
+    outer := Pushf2(inner, Op("load"), K.Invalid, "load failed")
+    p := outer.(*Elist)
+
+    if plain := p.FormatAs(PlainFormatter); plain != p.Error() {
+        t.Fatalf("FormatAs(PlainFormatter) = %q, want Error() = %q", plain, p.Error())
+    }
+
+    compact := p.FormatAs(CompactFormatter)
+    for _, want := range []string{"load", "[kind=invalid]", "load failed", "read", "file=a.txt"} {
+        if !strings.Contains(compact, want) {
+            t.Fatalf("CompactFormatter output %q missing %q", compact, want)
+        }
+    }
+
+    jsonStr := p.FormatAs(JSONFormatter)
+    var nodes []map[string]interface{}
+    if err := json.Unmarshal([]byte(jsonStr), &nodes); err != nil {
+        t.Fatalf("JSONFormatter output did not parse as JSON: %v", err)
+    }
+    if len(nodes) != 2 {
+        t.Fatalf("JSONFormatter produced %d nodes, want 2", len(nodes))
+    }
+    if nodes[0]["op"] != "load" || nodes[0]["kind"] != "invalid" {
+        t.Fatalf("JSONFormatter outer node = %v, want op=load kind=invalid", nodes[0])
+    }
+    if nodes[1]["op"] != "read" {
+        t.Fatalf("JSONFormatter inner node = %v, want op=read", nodes[1])
+    }
+}
+
+func TestRenderNonElistFallback(t *testing.T) {
+    plain := errors.New("boom")
+
+    if got := Render(plain, PlainFormatter); got != "boom" {
+        t.Fatalf("Render(plain, PlainFormatter) = %q, want %q", got, "boom")
+    }
+    if got := Render(plain, CompactFormatter); got != "boom" {
+        t.Fatalf("Render(plain, CompactFormatter) = %q, want %q", got, "boom")
+    }
+
+    jsonStr := Render(plain, JSONFormatter)
+    var nodes []map[string]interface{}
+    if err := json.Unmarshal([]byte(jsonStr), &nodes); err != nil {
+        t.Fatalf("Render(plain, JSONFormatter) did not parse as JSON: %v", err)
+    }
+    if len(nodes) != 1 || nodes[0]["msg"] != "boom" {
+        t.Fatalf("Render(plain, JSONFormatter) = %v, want a single node with msg=boom", nodes)
+    }
+}
+
+func TestIsAsAcrossFieldCarryingNode(t *testing.T) {
+    leaf := &fsPathError{Op: "stat"}
+    err := Pushf2(leaf, Op("load"), "wrap failed", "key", "val")
+
+    if !errors.Is(err, leaf) {
+        t.Fatalf("errors.Is(err, leaf) = false, want true")
+    }
+    var target *fsPathError
+    if !errors.As(err, &target) {
+        t.Fatalf("errors.As(err, &target) = false, want true")
+    }
+    if target != leaf {
+        t.Fatalf("errors.As recovered %v, want the original leaf %v", target, leaf)
+    }
+}
@@ -0,0 +1,162 @@
+// src/go/elist_kind.go   2026-7-29.
+// An error-category taxonomy for Elist, borrowed from eluv-io/errors-go.
+/*-------------------------------------------------------------------------
+Functions in this file.
+
+Elist::Kind
+- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+Newk
+Pushk
+KindOf
+IsKind
+-------------------------------------------------------------------------*/
+
+/*
+Kind classifies the general nature of an error, independently of its message
+text, so that calling code can handle a whole category of failures without
+having to know every specific sentinel error value that might produce it.
+
+    if errors.Is(err, elist.K.NotExist) { ... }
+
+K holds a small set of common kinds; applications are free to declare their
+own Kind values of their own, e.g.
+    const KindQuotaExceeded elist.Kind = "quota_exceeded"
+
+A Kind implements the standard "error" interface (via Error() below) purely
+so that it can be passed as the target of errors.Is(); Elist::Is() (see
+elist.go) special-cases a Kind target and matches it against the Kind
+attached to each node. Note that, since a type and a package-level function
+cannot share a name in Go, the chain-walking accessor is named KindOf(), not
+Kind(), even though it returns a Kind.
+*/
+package elist
+
+// External libraries.
+import "errors"
+
+// Kind classifies an error, e.g. elist.K.NotExist. See the package comment
+// above.
+type Kind string
+
+// Error lets a bare Kind value be passed as the target of errors.Is().
+func (k Kind) Error() string {
+    //------------------//
+    //   Kind::Error    //
+    //------------------//
+    return string(k)
+}   // End of function Kind::Error.
+
+// K holds the common, predefined kinds. Applications may declare additional
+// Kind values of their own instead of, or as well as, these.
+var K = struct {
+    IO         Kind
+    Invalid    Kind
+    NotExist   Kind
+    Permission Kind
+    Timeout    Kind
+    Cancelled  Kind
+    Internal   Kind
+}{
+    IO:         Kind("io"),
+    Invalid:    Kind("invalid"),
+    NotExist:   Kind("not_exist"),
+    Permission: Kind("permission"),
+    Timeout:    Kind("timeout"),
+    Cancelled:  Kind("cancelled"),
+    Internal:   Kind("internal"),
+}
+
+/*
+Return the Kind attached to this node, or "" if none was attached.
+*/
+func (p *Elist) Kind() Kind {
+    //------------------//
+    //   Elist::Kind    //
+    //------------------//
+    if p == nil {
+        return ""
+    }
+    return p.kind
+}   // End of function Elist::Kind.
+
+/*
+Create a new Elist error-message-stack node of the given Kind from a given
+string, exactly like New(), but with a Kind attached.
+Usage example:
+    return elist.Newk(elist.K.NotExist, "config.Load: file does not exist");
+*/
+func Newk(k Kind, s string) error {
+    //------------------//
+    //       Newk       //
+    //------------------//
+    p := newElist(s)
+    if p == nil {
+        return nil
+    }
+    p.kind = k
+    return p
+}   // End of function Newk.
+
+/*
+Push a new node of the given Kind onto the error e, exactly like Push(), but
+with a Kind attached.
+Usage example:
+    return elist.Pushk(E, elist.K.NotExist, "config.Load: file does not exist");
+*/
+func Pushk(e error, k Kind, s string) error {
+    //------------------//
+    //       Pushk      //
+    //------------------//
+    p := pushElist(e, s)
+    if p == nil {
+        return nil
+    }
+    p.kind = k
+    return p
+}   // End of function Pushk.
+
+/*
+Walk the chain of err (which need not be an *Elist) and return the outermost
+non-empty Kind found, whether attached to an Elist node directly or exposed by
+a wrapped non-Elist error which implements "interface{ Kind() Kind }". Returns
+"" if no node in the chain carries a Kind.
+*/
+func KindOf(err error) Kind {
+    //------------------//
+    //      KindOf      //
+    //------------------//
+    for err != nil {
+        if k, ok := err.(interface{ Kind() Kind }); ok {
+            if kind := k.Kind(); kind != "" {
+                return kind
+            }
+        }
+        err = errors.Unwrap(err)
+    }
+    return ""
+}   // End of function KindOf.
+
+/*
+Report whether any node in the chain of err carries the Kind k, whether
+attached to an *Elist node directly or exposed by a wrapped non-Elist error
+which implements "interface{ Kind() Kind }". errors.Is(err, k) alone is not
+enough here: Elist::Is() only special-cases a Kind target against *Elist
+nodes, so it never sees a Kind exposed via a plain Kind() method on some
+other wrapped error in the chain. IsKind() instead walks the whole chain the
+same way KindOf() does, so it matches k wherever in the chain it appears, not
+only at the outermost carrier.
+*/
+func IsKind(err error, k Kind) bool {
+    //------------------//
+    //      IsKind      //
+    //------------------//
+    for err != nil {
+        if kk, ok := err.(interface{ Kind() Kind }); ok {
+            if kind := kk.Kind(); kind != "" && kind == k {
+                return true
+            }
+        }
+        err = errors.Unwrap(err)
+    }
+    return false
+}   // End of function IsKind.
@@ -0,0 +1,155 @@
+// src/go/elist_stack.go   2026-7-29.
+// Optional per-node call-stack capture for Elist, and verbose formatting of it.
+/*-------------------------------------------------------------------------
+Functions in this file.
+
+SetStackCaptureEnabled
+- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+Elist::Format
+Elist::StackTrace
+- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+DeepestStack
+-------------------------------------------------------------------------*/
+
+/*
+Inspired by "pkg/errors" and "go-errors/errors", an Elist node can optionally
+capture the call-stack in effect at the moment the node is created, i.e. inside
+New(), Newf(), Push() and Pushf(). Capturing a stack on every error has a real
+cost (runtime.Callers() is not free), so capture is off by default and must be
+switched on explicitly with SetStackCaptureEnabled(true), typically once at
+process start-up in a development or debug build.
+
+When stack capture is enabled, fmt.Sprintf("%+v", err) prints each "Error N:"
+line exactly as Elist::Error() does, followed by the captured frames of that
+node, resolved to file:line and function name via runtime.CallersFrames(). The
+plain %v/%s verbs are unaffected; they remain byte-for-byte identical to
+Elist::Error().
+*/
+package elist
+
+// External libraries.
+import "errors"
+import "fmt"
+import "runtime"
+
+// The number of stack frames to skip when capturing, so that the first frame
+// recorded is the caller of New()/Newf()/Push()/Pushf(), not any of the
+// internal helper functions involved in capturing it.
+const stackSkipFrames = 4
+
+// The maximum number of frames captured per node. Elist call-stacks are
+// expected to be shallow; this is simply a sane upper bound.
+const stackMaxFrames = 32
+
+// Whether New(), Newf(), Push() and Pushf() capture a call-stack on every new
+// node. Off by default, as recommended for production use.
+var stackCaptureEnabled = false
+
+/*
+Turn per-node call-stack capture on or off. This affects every Elist node
+created afterwards; nodes which already exist keep whatever stack (or lack of
+one) they were created with.
+*/
+func SetStackCaptureEnabled(enabled bool) {
+    //---------------------------//
+    //  SetStackCaptureEnabled   //
+    //---------------------------//
+    stackCaptureEnabled = enabled
+}   // End of function SetStackCaptureEnabled.
+
+// Capture up to stackMaxFrames program-counters, skipping the given number of
+// frames. Returns nil when capture is disabled, so that disabled nodes carry
+// no overhead beyond the nil slice itself.
+func captureStack(skip int) []uintptr {
+    //------------------//
+    //   captureStack   //
+    //------------------//
+    if !stackCaptureEnabled {
+        return nil
+    }
+    var pcs [stackMaxFrames]uintptr
+    n := runtime.Callers(skip, pcs[:])
+    return append([]uintptr(nil), pcs[:n]...)
+}   // End of function captureStack.
+
+/*
+Return the call-stack captured at this node, resolved into runtime.Frame values,
+most recent call first (the caller of New()/Push() etc. comes first). Returns
+nil if this node was created while stack capture was disabled.
+*/
+func (p *Elist) StackTrace() []runtime.Frame {
+    //------------------------//
+    //   Elist::StackTrace    //
+    //------------------------//
+    if p == nil || len(p.pc) == 0 {
+        return nil
+    }
+    frames := runtime.CallersFrames(p.pc)
+    var result []runtime.Frame
+    for {
+        frame, more := frames.Next()
+        result = append(result, frame)
+        if !more {
+            break
+        }
+    }
+    return result
+}   // End of function Elist::StackTrace.
+
+/*
+Implement fmt.Formatter so that fmt.Sprintf("%+v", err) prints a verbose
+traceback: each "Error N: ..." line from Elist::Error(), followed by its
+captured stack frames (file:line and function name) when one was captured.
+%v and %s (without the '+' flag) print exactly what Elist::Error() returns.
+*/
+func (p *Elist) Format(state fmt.State, verb rune) {
+    //------------------//
+    //   Elist::Format  //
+    //------------------//
+    if p == nil {
+        return
+    }
+    switch verb {
+    case 'v':
+        if state.Flag('+') {
+            var n int = 1
+            for q := p; q != nil; q = q.next {
+                fmt.Fprint(state, elistErrorLine(n, q))
+                for _, frame := range q.StackTrace() {
+                    fmt.Fprintf(state, "\t%s\n\t\t%s:%d\n", frame.Function, frame.File, frame.Line)
+                }
+                n += 1
+            }
+            return
+        }
+        fmt.Fprint(state, p.Error())
+    case 's':
+        fmt.Fprint(state, p.Error())
+    default:
+        fmt.Fprintf(state, "%%!%c(*elist.Elist)", verb)
+    }
+}   // End of function Elist::Format.
+
+/*
+Walk the error-message-stack of err (which may or may not be an *Elist),
+visiting each node's captured stack in turn via its StackTrace() method, and
+return the oldest non-empty one found. A wrapped non-Elist error is consulted
+too, provided it implements "interface{ StackTrace() []runtime.Frame }",
+matching the convention used by "github.com/eluv-io/errors-go" and similar
+packages. Returns nil if no node in the chain carries a captured stack.
+*/
+func DeepestStack(err error) []runtime.Frame {
+    //------------------//
+    //   DeepestStack   //
+    //------------------//
+    var deepest []runtime.Frame
+    for err != nil {
+        if s, ok := err.(interface{ StackTrace() []runtime.Frame }); ok {
+            if st := s.StackTrace(); len(st) > 0 {
+                deepest = st
+            }
+        }
+        err = errors.Unwrap(err)
+    }
+    return deepest
+}   // End of function DeepestStack.
@@ -0,0 +1,93 @@
+// src/go/elist_stack_test.go   2026-7-29.
+// Tests for the optional per-node stack capture and verbose Format() added
+// on top of the original Elist package.
+package elist
+
+import (
+    "fmt"
+    "runtime"
+    "strings"
+    "testing"
+)
+
+// frameStackErr stands in for a third-party error which exposes a captured
+// stack the same way Elist nodes do, so DeepestStack() can be exercised
+// across a non-Elist boundary without depending on real runtime.Callers()
+// output.
+type frameStackErr struct {
+    frames []runtime.Frame
+}
+
+func (e *frameStackErr) Error() string               { return "frameStackErr" }
+func (e *frameStackErr) StackTrace() []runtime.Frame { return e.frames }
+
+func TestStackCaptureToggle(t *testing.T) {
+    SetStackCaptureEnabled(false)
+    disabled := New("disabled").(*Elist)
+    if frames := disabled.StackTrace(); frames != nil {
+        t.Fatalf("StackTrace() = %v while capture disabled, want nil", frames)
+    }
+
+    SetStackCaptureEnabled(true)
+    defer SetStackCaptureEnabled(false)
+    enabled := New("enabled").(*Elist)
+    if frames := enabled.StackTrace(); len(frames) == 0 {
+        t.Fatalf("StackTrace() is empty while capture enabled, want at least one frame")
+    }
+}
+
+func TestFormatVerboseMixedChain(t *testing.T) {
+    SetStackCaptureEnabled(true)
+    inner := New("inner")
+    SetStackCaptureEnabled(false)
+    defer SetStackCaptureEnabled(false)
+    outer := Push(inner, "outer")
+
+    verbose := fmt.Sprintf("%+v", outer)
+    if !strings.Contains(verbose, "Error 1: \"outer\".") {
+        t.Fatalf("verbose output missing Error 1 line: %q", verbose)
+    }
+    if !strings.Contains(verbose, "Error 2: \"inner\".") {
+        t.Fatalf("verbose output missing Error 2 line: %q", verbose)
+    }
+
+    idx1 := strings.Index(verbose, "Error 1: \"outer\".")
+    idx2 := strings.Index(verbose, "Error 2: \"inner\".")
+    between := verbose[idx1:idx2]
+    if strings.Contains(between, "\t") {
+        t.Fatalf("node with no captured stack printed frame lines: %q", between)
+    }
+    after := verbose[idx2:]
+    if !strings.Contains(after, "\t") {
+        t.Fatalf("node with a captured stack printed no frame lines: %q", after)
+    }
+}
+
+func TestFormatPlainVerbsMatchError(t *testing.T) {
+    SetStackCaptureEnabled(true)
+    inner := New("inner")
+    SetStackCaptureEnabled(false)
+    defer SetStackCaptureEnabled(false)
+    outer := Push(inner, "outer")
+
+    want := outer.Error()
+    if got := fmt.Sprintf("%v", outer); got != want {
+        t.Fatalf("%%v = %q, want %q", got, want)
+    }
+    if got := fmt.Sprintf("%s", outer); got != want {
+        t.Fatalf("%%s = %q, want %q", got, want)
+    }
+}
+
+func TestDeepestStackCrossesElistBoundary(t *testing.T) {
+    leaf := &frameStackErr{frames: []runtime.Frame{{Function: "leaf.Fn", File: "leaf.go", Line: 7}}}
+
+    SetStackCaptureEnabled(true)
+    defer SetStackCaptureEnabled(false)
+    err := Push(leaf, "outer")
+
+    got := DeepestStack(err)
+    if len(got) != 1 || got[0].Function != "leaf.Fn" {
+        t.Fatalf("DeepestStack(err) = %v, want the leaf's own frame", got)
+    }
+}
@@ -0,0 +1,151 @@
+// src/go/elist_test.go   2026-7-29.
+// Tests for the errors.Is/As/Unwrap interop, Kind matching and chain
+// introspection helpers added on top of the original Elist package.
+package elist
+
+import (
+    "errors"
+    "fmt"
+    "io"
+    "testing"
+)
+
+// uncomparable wraps a slice, so comparing two uncomparable values with "=="
+// panics at runtime instead of evaluating to false. The Error() method has a
+// value receiver, so the struct itself (not a pointer to it) is what ends up
+// stored as an Elist payload and compared, reproducing the panic.
+type uncomparable struct {
+    tags []string
+}
+
+func (u uncomparable) Error() string {
+    return "uncomparable error"
+}
+
+// timeoutError exposes its category via Kind() rather than being an *Elist,
+// the way a third-party error might integrate with KindOf()/IsKind().
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "timed out" }
+func (timeoutError) Kind() Kind    { return K.Timeout }
+
+func TestUnwrapChainReachesInnermostLeaf(t *testing.T) {
+    leaf := errors.New("leaf cause")
+    err := Push(leaf, "inner")
+    err = Push(err, "middle")
+    err = Push(err, "outermost wrapper")
+
+    var got error = err
+    var n int
+    for {
+        next := errors.Unwrap(got)
+        if next == nil {
+            break
+        }
+        got = next
+        n += 1
+        if n > 10 {
+            t.Fatalf("errors.Unwrap never terminated")
+        }
+    }
+    if got != leaf {
+        t.Fatalf("Unwrap chain ended at %v, want the original leaf %v", got, leaf)
+    }
+}
+
+// fsPathError stands in for a concrete error type that fmt.Errorf("%w", ...)
+// might wrap, so TestIsAsAcrossFmtErrorfBoundary can confirm errors.As()
+// recovers it through both the fmt.Errorf boundary and the Elist boundary.
+type fsPathError struct{ Op string }
+
+func (e *fsPathError) Error() string { return e.Op }
+
+func TestIsAsAcrossFmtErrorfBoundary(t *testing.T) {
+    leaf := &fsPathError{Op: "open"}
+    err := Push(fmt.Errorf("open config: %w", leaf), "loadConfig")
+
+    if !errors.Is(err, leaf) {
+        t.Fatalf("errors.Is(err, leaf) = false, want true")
+    }
+
+    var target *fsPathError
+    if !errors.As(err, &target) {
+        t.Fatalf("errors.As(err, &target) = false, want true")
+    }
+    if target != leaf {
+        t.Fatalf("errors.As recovered %v, want the original leaf %v", target, leaf)
+    }
+
+    if errors.Is(err, io.EOF) {
+        t.Fatalf("errors.Is(err, io.EOF) = true, want false")
+    }
+}
+
+func TestIsDoesNotPanicOnUncomparablePayload(t *testing.T) {
+    wrapped := Push(uncomparable{tags: []string{"a", "b"}}, "wrapper")
+    var other error = uncomparable{tags: []string{"c"}}
+
+    var matched bool
+    func() {
+        defer func() {
+            if r := recover(); r != nil {
+                t.Fatalf("errors.Is panicked: %v", r)
+            }
+        }()
+        matched = errors.Is(wrapped, other)
+    }()
+    if matched {
+        t.Fatalf("errors.Is matched two distinct uncomparable errors")
+    }
+}
+
+func TestIsKindMatchesElistAndWrappedError(t *testing.T) {
+    viaElist := Pushk(New("root cause"), K.NotExist, "config.Load")
+    if !IsKind(viaElist, K.NotExist) {
+        t.Fatalf("IsKind did not match a Kind attached directly to an *Elist node")
+    }
+    if IsKind(viaElist, K.Timeout) {
+        t.Fatalf("IsKind matched a Kind that was never attached")
+    }
+
+    viaWrapped := Push(timeoutError{}, "dial")
+    if !IsKind(viaWrapped, K.Timeout) {
+        t.Fatalf("IsKind did not match a Kind exposed by a wrapped non-Elist error")
+    }
+    if KindOf(viaWrapped) != K.Timeout {
+        t.Fatalf("KindOf(viaWrapped) = %q, want %q", KindOf(viaWrapped), K.Timeout)
+    }
+}
+
+func TestCauseListWalkOrdering(t *testing.T) {
+    leaf := errors.New("leaf cause")
+    err := Push(leaf, "inner")
+    err = Push(err, "outer")
+
+    if cause := Cause(err); cause != leaf {
+        t.Fatalf("Cause(err) = %v, want the original leaf %v", cause, leaf)
+    }
+    if root := Root(err); root != leaf {
+        t.Fatalf("Root(err) = %v, want the original leaf %v", root, leaf)
+    }
+
+    list := List(err)
+    if len(list) != 3 {
+        t.Fatalf("List(err) has %d entries, want 3", len(list))
+    }
+    if list[0].Error() != "outer" {
+        t.Fatalf("List(err)[0] = %q, want the outermost frame %q", list[0].Error(), "outer")
+    }
+    if list[2] != leaf {
+        t.Fatalf("List(err)[2] = %v, want the original leaf %v", list[2], leaf)
+    }
+
+    var seen []string
+    Walk(err, func(i int, msg string, wrapped error) bool {
+        seen = append(seen, msg)
+        return true
+    })
+    if len(seen) != 3 || seen[0] != "outer" || seen[2] != "leaf cause" {
+        t.Fatalf("Walk visited %v in the wrong order", seen)
+    }
+}